@@ -4,18 +4,66 @@ import (
 	"context"
 	"log"
 	"log/slog"
+	"net"
 	"net/http"
 	"os"
-	"time"
+	"strconv"
+	"strings"
 
 	"brainball/api/internal/grpcclient"
 	"brainball/api/internal/health"
 	"brainball/api/internal/middleware"
 	"brainball/api/internal/rest"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"brainball/api/pkg/proto"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/metadata"
 )
 
+// word2animalService is the fully-qualified name the word2animal server
+// registers with the gRPC Health Checking Protocol.
+const word2animalService = "word2animal.Word2Animal"
+
+// Defaults for the rate limit and body size middleware, overridable via
+// RATE_LIMIT_RPS, RATE_LIMIT_BURST, RATE_LIMIT_GLOBAL_RPS,
+// RATE_LIMIT_GLOBAL_BURST, and MAX_BODY_BYTES. The global bucket defaults
+// much higher than the per-IP one: it exists to cap aggregate abuse, not to
+// re-impose the per-IP limit on the server as a whole.
+const (
+	defaultRateLimitRPS         = 5
+	defaultRateLimitBurst       = 10
+	defaultRateLimitGlobalRPS   = 200
+	defaultRateLimitGlobalBurst = 400
+	defaultMaxBodyBytes         = 1 << 20 // 1MiB
+)
+
+// rateLimitExemptPaths bypasses rate limiting entirely for health endpoints.
+// A k8s liveness/readiness probe can share an IP (or the global bucket) with
+// real traffic; 429-ing it would flip /ready to look down and trigger pod
+// restarts for reasons that have nothing to do with the backend's health.
+var rateLimitExemptPaths = map[string]bool{
+	"/health": true,
+	"/ready":  true,
+}
+
+// buildHandler composes the full middleware chain around mux: request ID
+// tagging, access logging, per-IP/global rate limiting, and a request body
+// cap, in that order from the outside in. rateLimitExemptPaths skip the
+// rate limit and body cap entirely. Pulled out of main so it can be
+// exercised directly by tests without dialing a real word2animal backend.
+func buildHandler(mux http.Handler, logger *slog.Logger, rateLimitRPS float64, rateLimitBurst int, rateLimitGlobalRPS float64, rateLimitGlobalBurst int, trustedProxies []*net.IPNet, maxBodyBytes int64) http.Handler {
+	rateLimit := middleware.RateLimit(rateLimitRPS, rateLimitBurst, rateLimitGlobalRPS, rateLimitGlobalBurst, trustedProxies)
+	maxBody := middleware.MaxBodyBytes(maxBodyBytes)
+	limited := rateLimit(maxBody(mux))
+	gated := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if rateLimitExemptPaths[r.URL.Path] {
+			mux.ServeHTTP(w, r)
+			return
+		}
+		limited.ServeHTTP(w, r)
+	})
+	return middleware.RequestID(middleware.Logging(logger)(gated))
+}
+
 func main() {
 	addr := os.Getenv("HTTP_ADDR")
 	if addr == "" {
@@ -26,38 +74,110 @@ func main() {
 		word2animalAddr = "localhost:50051"
 	}
 
-	readyFunc := func() error {
-		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
-		defer cancel()
-		conn, err := grpc.DialContext(ctx, word2animalAddr,
-			grpc.WithTransportCredentials(insecure.NewCredentials()),
-			grpc.WithBlock())
-		if err != nil {
-			return err
+	// Word2Animal gRPC client backs both the /v1 gateway mux and /ready.
+	// word2animal is optional at startup (see NewWord2AnimalClient): the dial
+	// is non-blocking and returns immediately, connecting in the background,
+	// so it never holds up main() on a slow DNS lookup or an unready backend.
+	ctx := context.Background()
+	clientCfg := grpcclient.ClientConfig{
+		CAFile:    os.Getenv("WORD2ANIMAL_TLS_CA"),
+		CertFile:  os.Getenv("WORD2ANIMAL_TLS_CERT"),
+		KeyFile:   os.Getenv("WORD2ANIMAL_TLS_KEY"),
+		TokenFile: os.Getenv("WORD2ANIMAL_TOKEN_FILE"),
+	}
+	wc, err := grpcclient.NewWord2AnimalClient(ctx, word2animalAddr, clientCfg)
+	if err != nil {
+		log.Fatalf("word2animal client: %v", err)
+	}
+	defer wc.Close()
+
+	gwmux := runtime.NewServeMux(runtime.WithMetadata(func(ctx context.Context, r *http.Request) metadata.MD {
+		if id := middleware.RequestIDFromContext(r.Context()); id != "" {
+			return metadata.Pairs("x-request-id", id)
 		}
-		conn.Close()
 		return nil
+	}))
+	if err := proto.RegisterWord2AnimalHandler(ctx, gwmux, wc.Conn()); err != nil {
+		log.Fatalf("register word2animal gateway: %v", err)
 	}
 
-	// Word2Animal gRPC client for /v1/text-to-animal (optional at startup; handler returns 503 if unreachable)
-	ctx := context.Background()
-	wc, err := grpcclient.NewWord2AnimalClient(ctx, word2animalAddr)
-	if err != nil {
-		log.Printf("word2animal client (will retry on request): %v", err)
-		wc = nil
-	} else {
-		defer wc.Close()
-	}
-	handler := rest.NewTextToAnimalHandler(word2animalAddr, wc)
+	probe := health.NewGRPCProbe(wc.Conn(), word2animalService)
 
 	mux := http.NewServeMux()
 	mux.HandleFunc("/health", health.Health)
-	mux.HandleFunc("/ready", health.Ready(readyFunc))
-	mux.Handle("/v1/text-to-animal", handler)
+	mux.HandleFunc("/ready", health.Ready(probe.Ready))
+	mux.Handle("/v1/text-to-animal:batch", rest.NewBatchGetAnimalHandler(wc))
+	mux.Handle("/v1/", gwmux)
 
-	handlerWithLogging := middleware.Logging(slog.Default())(mux)
+	handler := buildHandler(mux, slog.Default(),
+		envFloat("RATE_LIMIT_RPS", defaultRateLimitRPS),
+		envInt("RATE_LIMIT_BURST", defaultRateLimitBurst),
+		envFloat("RATE_LIMIT_GLOBAL_RPS", defaultRateLimitGlobalRPS),
+		envInt("RATE_LIMIT_GLOBAL_BURST", defaultRateLimitGlobalBurst),
+		parseTrustedProxies(os.Getenv("RATE_LIMIT_TRUSTED_PROXIES")),
+		envInt64("MAX_BODY_BYTES", defaultMaxBodyBytes),
+	)
 	log.Printf("API listening on %s", addr)
-	if err := http.ListenAndServe(addr, handlerWithLogging); err != nil {
+
+	httpsCert, httpsKey := os.Getenv("HTTPS_CERT"), os.Getenv("HTTPS_KEY")
+	if httpsCert != "" && httpsKey != "" {
+		err = http.ListenAndServeTLS(addr, httpsCert, httpsKey, handler)
+	} else {
+		err = http.ListenAndServe(addr, handler)
+	}
+	if err != nil {
 		log.Fatal(err)
 	}
 }
+
+func envFloat(key string, fallback float64) float64 {
+	v, err := strconv.ParseFloat(os.Getenv(key), 64)
+	if err != nil {
+		return fallback
+	}
+	return v
+}
+
+func envInt(key string, fallback int) int {
+	v, err := strconv.Atoi(os.Getenv(key))
+	if err != nil {
+		return fallback
+	}
+	return v
+}
+
+func envInt64(key string, fallback int64) int64 {
+	v, err := strconv.ParseInt(os.Getenv(key), 10, 64)
+	if err != nil {
+		return fallback
+	}
+	return v
+}
+
+// parseTrustedProxies parses a comma-separated list of CIDRs (e.g. from
+// RATE_LIMIT_TRUSTED_PROXIES), skipping anything that doesn't parse. A
+// single IP like "10.0.0.1" is accepted as shorthand for "10.0.0.1/32".
+func parseTrustedProxies(s string) []*net.IPNet {
+	var nets []*net.IPNet
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		if !strings.Contains(part, "/") {
+			if ip := net.ParseIP(part); ip != nil {
+				if ip.To4() != nil {
+					part += "/32"
+				} else {
+					part += "/128"
+				}
+			}
+		}
+		if _, cidr, err := net.ParseCIDR(part); err == nil {
+			nets = append(nets, cidr)
+		} else {
+			log.Printf("ignoring invalid RATE_LIMIT_TRUSTED_PROXIES entry %q: %v", part, err)
+		}
+	}
+	return nets
+}
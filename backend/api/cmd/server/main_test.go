@@ -0,0 +1,71 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestBuildHandlerPreservesFlusher drives a streaming endpoint through the
+// real middleware chain built by buildHandler (RequestID -> Logging ->
+// RateLimit -> MaxBodyBytes) and checks the handler's http.Flusher type
+// assertion still succeeds. It would have caught chunk0-5's Logging
+// middleware silently dropping Flush and breaking chunk0-6's SSE endpoint.
+func TestBuildHandlerPreservesFlusher(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/text-to-animal:batch", func(w http.ResponseWriter, r *http.Request) {
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("event: animal\ndata: {}\n\n"))
+		flusher.Flush()
+	})
+
+	handler := buildHandler(mux, nil, 100, 100, 1000, 1000, nil, 1<<20)
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/text-to-animal:batch", nil)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d; body: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+	if !rec.Flushed {
+		t.Fatalf("handler never reached Flush(); Logging's responseWriter likely dropped http.Flusher again")
+	}
+}
+
+// TestBuildHandlerExemptsHealthPathsFromRateLimit checks /health and /ready
+// keep serving once the per-IP and global buckets are both exhausted, so a
+// k8s probe sharing an IP (or the global bucket) with real traffic can't get
+// 429'd into a false-negative readiness check.
+func TestBuildHandlerExemptsHealthPathsFromRateLimit(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc("/ready", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	handler := buildHandler(mux, nil, 1, 1, 1, 1, nil, 1<<20)
+
+	// Burn through the per-IP and global burst of 1 on a non-exempt path.
+	burn := httptest.NewRequest(http.MethodGet, "/v1/text-to-animal", nil)
+	burn.RemoteAddr = "10.0.0.1:1234"
+	handler.ServeHTTP(httptest.NewRecorder(), burn)
+
+	for _, path := range []string{"/health", "/ready"} {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.RemoteAddr = "10.0.0.1:1234"
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("%s: status = %d, want %d; a health path should never be rate limited", path, rec.Code, http.StatusOK)
+		}
+	}
+}
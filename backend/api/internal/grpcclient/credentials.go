@@ -0,0 +1,124 @@
+package grpcclient
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// ClientConfig configures transport and per-RPC credentials for
+// NewWord2AnimalClient.
+type ClientConfig struct {
+	// CAFile, CertFile, and KeyFile configure TLS. CAFile alone verifies the
+	// server; all three together additionally present a client certificate,
+	// i.e. mTLS.
+	CAFile     string
+	CertFile   string
+	KeyFile    string
+	ServerName string
+
+	// PerRPCToken is sent as a bearer token on every RPC. If TokenFile is set
+	// instead, the token is read from that file and reloaded whenever its
+	// mtime advances, so a rotated JWT is picked up without a restart.
+	PerRPCToken string
+	TokenFile   string
+}
+
+func (cfg ClientConfig) tlsEnabled() bool {
+	return cfg.CAFile != "" || cfg.CertFile != ""
+}
+
+func (cfg ClientConfig) transportCredentials() (credentials.TransportCredentials, error) {
+	if !cfg.tlsEnabled() {
+		return insecure.NewCredentials(), nil
+	}
+	tlsCfg := &tls.Config{ServerName: cfg.ServerName}
+	if cfg.CAFile != "" {
+		pem, err := os.ReadFile(cfg.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("read CA file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in %s", cfg.CAFile)
+		}
+		tlsCfg.RootCAs = pool
+	}
+	if cfg.CertFile != "" || cfg.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("load client cert: %w", err)
+		}
+		tlsCfg.Certificates = []tls.Certificate{cert}
+	}
+	return credentials.NewTLS(tlsCfg), nil
+}
+
+// tokenCredentials implements credentials.PerRPCCredentials, attaching a
+// bearer token to every RPC. When backed by a token file, the token is
+// re-read whenever the file's mtime changes.
+type tokenCredentials struct {
+	tokenFile string
+
+	mu      sync.Mutex
+	token   string
+	modTime time.Time
+}
+
+// newTokenCredentials returns nil if cfg has no token configured, so callers
+// can skip grpc.WithPerRPCCredentials entirely. Callers must reject a
+// configured token when cfg isn't using TLS (see tokenRequiresTLS) — a
+// bearer credential is only as safe as the channel that carries it, and
+// RequireTransportSecurity below is unconditional.
+func newTokenCredentials(cfg ClientConfig) *tokenCredentials {
+	if cfg.PerRPCToken == "" && cfg.TokenFile == "" {
+		return nil
+	}
+	return &tokenCredentials{
+		token:     cfg.PerRPCToken,
+		tokenFile: cfg.TokenFile,
+	}
+}
+
+func (t *tokenCredentials) GetRequestMetadata(ctx context.Context, _ ...string) (map[string]string, error) {
+	token, err := t.currentToken()
+	if err != nil {
+		return nil, fmt.Errorf("word2animal token: %w", err)
+	}
+	return map[string]string{"authorization": "Bearer " + token}, nil
+}
+
+// RequireTransportSecurity always returns true: a bearer token must never be
+// sent over a plaintext connection, regardless of how ClientConfig is set up.
+func (t *tokenCredentials) RequireTransportSecurity() bool {
+	return true
+}
+
+func (t *tokenCredentials) currentToken() (string, error) {
+	if t.tokenFile == "" {
+		return t.token, nil
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	info, err := os.Stat(t.tokenFile)
+	if err != nil {
+		return "", fmt.Errorf("stat token file: %w", err)
+	}
+	if info.ModTime().After(t.modTime) {
+		b, err := os.ReadFile(t.tokenFile)
+		if err != nil {
+			return "", fmt.Errorf("read token file: %w", err)
+		}
+		t.token = strings.TrimSpace(string(b))
+		t.modTime = info.ModTime()
+	}
+	return t.token, nil
+}
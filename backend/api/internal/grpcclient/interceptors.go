@@ -0,0 +1,223 @@
+package grpcclient
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"strconv"
+	"sync"
+	"time"
+
+	"brainball/api/pkg/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// defaultCallTimeout is applied by deadlineInterceptor when the caller's
+	// context carries no deadline of its own.
+	defaultCallTimeout = 5 * time.Second
+
+	retryMaxAttempts = 4
+	retryBaseDelay   = 100 * time.Millisecond
+	retryMaxDelay    = 2 * time.Second
+	retryJitterFrac  = 0.2
+
+	circuitBreakerThreshold = 5
+	circuitBreakerCooldown  = 10 * time.Second
+
+	// MaxTextLength bounds GetAnimalRequest/BatchGetAnimalRequest text, since
+	// proto3's plain `string text = 1` carries no such rule itself. Both the
+	// gateway-routed GetAnimal call (via validateInterceptor below) and the
+	// hand-written batch endpoint (rest.NewBatchGetAnimalHandler) enforce it.
+	MaxTextLength = 500
+)
+
+var retryableCodes = map[codes.Code]bool{
+	codes.Unavailable:       true,
+	codes.DeadlineExceeded:  true,
+	codes.ResourceExhausted: true,
+}
+
+// deadlineInterceptor injects defaultCallTimeout when the caller forgot one,
+// so a hung RPC can't block its caller forever.
+func deadlineInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if _, ok := ctx.Deadline(); !ok {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, defaultCallTimeout)
+			defer cancel()
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// validateInterceptor rejects GetAnimalRequest.Text that's empty or over
+// MaxTextLength before it ever reaches the wire. It runs ahead of the
+// circuit breaker and retry interceptors so a client input error fails fast
+// without counting against the breaker or being retried.
+func validateInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if r, ok := req.(*proto.GetAnimalRequest); ok {
+			if r.Text == "" {
+				return status.Error(codes.InvalidArgument, "text must not be empty")
+			}
+			if len(r.Text) > MaxTextLength {
+				return status.Errorf(codes.InvalidArgument, "text must be at most %d characters", MaxTextLength)
+			}
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// retryInterceptor retries retryableCodes with jittered exponential backoff,
+// honoring a server-sent grpc-retry-pushback-ms trailer when present.
+func retryInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		var (
+			err      error
+			delay    time.Duration
+			pushback bool
+		)
+		for attempt := 0; attempt < retryMaxAttempts; attempt++ {
+			if attempt > 0 {
+				if !pushback {
+					delay = backoff(attempt)
+				}
+				timer := time.NewTimer(delay)
+				select {
+				case <-ctx.Done():
+					timer.Stop()
+					return ctx.Err()
+				case <-timer.C:
+				}
+			}
+			var trailer metadata.MD
+			callOpts := append(append([]grpc.CallOption{}, opts...), grpc.Trailer(&trailer))
+			err = invoker(ctx, method, req, reply, cc, callOpts...)
+			if err == nil {
+				return nil
+			}
+			if !retryableCodes[status.Code(err)] {
+				return err
+			}
+			delay, pushback = pushbackDelay(trailer)
+		}
+		return err
+	}
+}
+
+func backoff(attempt int) time.Duration {
+	d := time.Duration(float64(retryBaseDelay) * math.Pow(2, float64(attempt-1)))
+	if d > retryMaxDelay {
+		d = retryMaxDelay
+	}
+	jitter := 1 + (rand.Float64()*2-1)*retryJitterFrac
+	return time.Duration(float64(d) * jitter)
+}
+
+func pushbackDelay(trailer metadata.MD) (time.Duration, bool) {
+	vals := trailer.Get("grpc-retry-pushback-ms")
+	if len(vals) == 0 {
+		return 0, false
+	}
+	ms, err := strconv.Atoi(vals[0])
+	if err != nil || ms < 0 {
+		return 0, false
+	}
+	return time.Duration(ms) * time.Millisecond, true
+}
+
+// circuitBreaker trips per-method after circuitBreakerThreshold consecutive
+// failures and fails fast with codes.Unavailable until circuitBreakerCooldown
+// has passed, at which point it half-opens to let a single probe through.
+type circuitBreaker struct {
+	mu     sync.Mutex
+	states map[string]*breakerState
+}
+
+type breakerState struct {
+	consecutiveFailures int
+	openUntil           time.Time
+	// probing is true while a single half-open probe request is in flight,
+	// so concurrent callers can't all rush the backend at once.
+	probing bool
+}
+
+func newCircuitBreaker() *circuitBreaker {
+	return &circuitBreaker{states: make(map[string]*breakerState)}
+}
+
+func (cb *circuitBreaker) interceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if cb.open(method) {
+			return status.Errorf(codes.Unavailable, "circuit breaker open for %s", method)
+		}
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		cb.record(method, err)
+		return err
+	}
+}
+
+// streamInterceptor applies the same breaker to stream creation. It can only
+// observe the initial Streamer call, not errors surfaced later via Recv, but
+// that's enough to stop a down backend from accepting new streams.
+func (cb *circuitBreaker) streamInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		if cb.open(method) {
+			return nil, status.Errorf(codes.Unavailable, "circuit breaker open for %s", method)
+		}
+		stream, err := streamer(ctx, desc, cc, method, opts...)
+		cb.record(method, err)
+		return stream, err
+	}
+}
+
+func (cb *circuitBreaker) open(method string) bool {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	st, ok := cb.states[method]
+	if !ok || st.consecutiveFailures < circuitBreakerThreshold {
+		return false
+	}
+	if st.probing {
+		return true
+	}
+	if time.Now().After(st.openUntil) {
+		// Half-open: claim the single probe slot and let this caller through;
+		// record() releases it once the probe completes.
+		st.probing = true
+		return false
+	}
+	return true
+}
+
+// record reports the outcome of a call against method. Only errors in
+// retryableCodes count as breaker failures, the same set retryInterceptor
+// retries on: a backend-rejected request (InvalidArgument, NotFound, ...) is
+// the caller's fault, not evidence the backend is down, and shouldn't trip
+// the breaker for every other caller of that method.
+func (cb *circuitBreaker) record(method string, err error) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	st, ok := cb.states[method]
+	if !ok {
+		st = &breakerState{}
+		cb.states[method] = st
+	}
+	st.probing = false
+	if err == nil {
+		st.consecutiveFailures = 0
+		return
+	}
+	if !retryableCodes[status.Code(err)] {
+		// Not a sign of backend health either way; leave the streak alone.
+		return
+	}
+	st.consecutiveFailures++
+	if st.consecutiveFailures >= circuitBreakerThreshold {
+		st.openUntil = time.Now().Add(circuitBreakerCooldown)
+	}
+}
@@ -0,0 +1,183 @@
+package grpcclient
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"brainball/api/pkg/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestBackoffWithinJitterBounds(t *testing.T) {
+	for attempt := 1; attempt <= 6; attempt++ {
+		base := float64(retryBaseDelay) * pow2(attempt-1)
+		if base > float64(retryMaxDelay) {
+			base = float64(retryMaxDelay)
+		}
+		minD := time.Duration(base * (1 - retryJitterFrac))
+		maxD := time.Duration(base * (1 + retryJitterFrac))
+		for i := 0; i < 20; i++ {
+			d := backoff(attempt)
+			if d < minD || d > maxD {
+				t.Fatalf("attempt %d: backoff %v outside [%v, %v]", attempt, d, minD, maxD)
+			}
+		}
+	}
+}
+
+func pow2(n int) float64 {
+	v := 1.0
+	for i := 0; i < n; i++ {
+		v *= 2
+	}
+	return v
+}
+
+func TestPushbackDelay(t *testing.T) {
+	cases := []struct {
+		name    string
+		trailer metadata.MD
+		wantOK  bool
+		want    time.Duration
+	}{
+		{"missing", metadata.MD{}, false, 0},
+		{"valid", metadata.Pairs("grpc-retry-pushback-ms", "250"), true, 250 * time.Millisecond},
+		{"negative", metadata.Pairs("grpc-retry-pushback-ms", "-1"), false, 0},
+		{"non-numeric", metadata.Pairs("grpc-retry-pushback-ms", "soon"), false, 0},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d, ok := pushbackDelay(tc.trailer)
+			if ok != tc.wantOK || d != tc.want {
+				t.Fatalf("pushbackDelay(%v) = (%v, %v), want (%v, %v)", tc.trailer, d, ok, tc.want, tc.wantOK)
+			}
+		})
+	}
+}
+
+func TestCircuitBreakerTripsAfterThreshold(t *testing.T) {
+	cb := newCircuitBreaker()
+	const method = "/word2animal.Word2Animal/GetAnimal"
+
+	for i := 0; i < circuitBreakerThreshold-1; i++ {
+		if cb.open(method) {
+			t.Fatalf("breaker opened early after %d failures", i)
+		}
+		cb.record(method, errUnavailable)
+	}
+	if cb.open(method) {
+		t.Fatalf("breaker should still be closed one failure short of threshold")
+	}
+	cb.record(method, errUnavailable)
+	if !cb.open(method) {
+		t.Fatalf("breaker should be open after %d consecutive failures", circuitBreakerThreshold)
+	}
+}
+
+func TestCircuitBreakerClosesOnSuccess(t *testing.T) {
+	cb := newCircuitBreaker()
+	const method = "/word2animal.Word2Animal/GetAnimal"
+
+	for i := 0; i < circuitBreakerThreshold; i++ {
+		cb.record(method, errUnavailable)
+	}
+	if !cb.open(method) {
+		t.Fatalf("expected breaker open after threshold failures")
+	}
+	cb.states[method].openUntil = time.Now().Add(-time.Second) // force cooldown to have elapsed
+	if cb.open(method) {
+		t.Fatalf("expected a half-open probe to be let through")
+	}
+	cb.record(method, nil)
+	if cb.open(method) {
+		t.Fatalf("expected breaker closed after a successful probe")
+	}
+}
+
+func TestCircuitBreakerIgnoresNonRetryableErrors(t *testing.T) {
+	cb := newCircuitBreaker()
+	const method = "/word2animal.Word2Animal/GetAnimal"
+	errInvalidArgument := status.Error(codes.InvalidArgument, "bad text")
+
+	for i := 0; i < circuitBreakerThreshold*3; i++ {
+		cb.record(method, errInvalidArgument)
+	}
+	if cb.open(method) {
+		t.Fatalf("breaker should not trip on caller errors the backend itself rejected")
+	}
+}
+
+func TestCircuitBreakerHalfOpenIsSingleFlight(t *testing.T) {
+	cb := newCircuitBreaker()
+	const method = "/word2animal.Word2Animal/GetAnimal"
+
+	for i := 0; i < circuitBreakerThreshold; i++ {
+		cb.record(method, errUnavailable)
+	}
+	cb.states[method].openUntil = time.Now().Add(-time.Second)
+
+	var admitted int32
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if !cb.open(method) {
+				atomic.AddInt32(&admitted, 1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if admitted != 1 {
+		t.Fatalf("expected exactly 1 half-open probe admitted, got %d", admitted)
+	}
+}
+
+func TestValidateInterceptorRejectsEmptyAndOverlongText(t *testing.T) {
+	cases := []struct {
+		name    string
+		text    string
+		wantErr bool
+	}{
+		{"empty", "", true},
+		{"within bound", strings.Repeat("a", MaxTextLength), false},
+		{"over bound", strings.Repeat("a", MaxTextLength+1), true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var invoked bool
+			invoker := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+				invoked = true
+				return nil
+			}
+			err := validateInterceptor()(context.Background(), "/word2animal.Word2Animal/GetAnimal",
+				&proto.GetAnimalRequest{Text: tc.text}, nil, nil, invoker)
+
+			if tc.wantErr {
+				if err == nil || status.Code(err) != codes.InvalidArgument {
+					t.Fatalf("err = %v, want codes.InvalidArgument", err)
+				}
+				if invoked {
+					t.Fatalf("invoker should not run when validation rejects the request")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !invoked {
+				t.Fatalf("invoker should run once validation passes")
+			}
+		})
+	}
+}
+
+var errUnavailable = status.Error(codes.Unavailable, "unavailable")
@@ -5,13 +5,16 @@ import (
 	"fmt"
 	"strings"
 
+	"brainball/api/internal/middleware"
 	"brainball/api/pkg/proto"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
 	_ "google.golang.org/grpc/resolver/dns"
 )
 
-// Word2AnimalClient dials word2animal gRPC and calls GetAnimal.
+// Word2AnimalClient dials word2animal gRPC. GetAnimal is served directly off
+// Conn() by the grpc-gateway mux; this type exists for BatchGetAnimal and for
+// sharing the connection with health checks.
 type Word2AnimalClient struct {
 	conn   *grpc.ClientConn
 	client proto.Word2AnimalClient
@@ -26,12 +29,35 @@ func dialTarget(addr string) string {
 	return "dns:///" + addr
 }
 
-// NewWord2AnimalClient connects to addr and returns a client.
-func NewWord2AnimalClient(ctx context.Context, addr string) (*Word2AnimalClient, error) {
+// NewWord2AnimalClient connects to addr using cfg's transport and per-RPC
+// credentials and returns a client. The zero value of ClientConfig dials
+// insecurely, which is only appropriate for local development.
+//
+// The dial is non-blocking: it returns as soon as the conn is constructed,
+// without waiting for a connection to actually come up. word2animal is
+// optional at startup, the same way /ready already treats it (see
+// health.GRPCProbe) — a DNS hiccup or a backend that isn't up yet shouldn't
+// stop this process from serving /health while gRPC connects and retries in
+// the background.
+func NewWord2AnimalClient(ctx context.Context, addr string, cfg ClientConfig) (*Word2AnimalClient, error) {
+	if (cfg.PerRPCToken != "" || cfg.TokenFile != "") && !cfg.tlsEnabled() {
+		return nil, fmt.Errorf("word2animal: a per-RPC token requires TLS (set WORD2ANIMAL_TLS_CA/CERT/KEY)")
+	}
 	target := dialTarget(addr)
-	conn, err := grpc.DialContext(ctx, target,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-		grpc.WithBlock())
+	transportCreds, err := cfg.transportCredentials()
+	if err != nil {
+		return nil, fmt.Errorf("word2animal transport credentials: %w", err)
+	}
+	cb := newCircuitBreaker()
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(transportCreds),
+		grpc.WithChainUnaryInterceptor(deadlineInterceptor(), validateInterceptor(), cb.interceptor(), retryInterceptor()),
+		grpc.WithChainStreamInterceptor(cb.streamInterceptor()),
+	}
+	if perRPC := newTokenCredentials(cfg); perRPC != nil {
+		opts = append(opts, grpc.WithPerRPCCredentials(perRPC))
+	}
+	conn, err := grpc.DialContext(ctx, target, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("word2animal dial: %w", err)
 	}
@@ -41,16 +67,23 @@ func NewWord2AnimalClient(ctx context.Context, addr string) (*Word2AnimalClient,
 	}, nil
 }
 
-// GetAnimal returns the animal for the given text.
-func (c *Word2AnimalClient) GetAnimal(ctx context.Context, text string) (string, float32, error) {
-	resp, err := c.client.GetAnimal(ctx, &proto.GetAnimalRequest{Text: text})
-	if err != nil {
-		return "", 0, err
+// BatchGetAnimal opens a server-streaming call classifying texts, returning
+// the stream so the caller can read one response per text as it arrives. If
+// ctx carries a request ID, it's forwarded as x-request-id metadata.
+func (c *Word2AnimalClient) BatchGetAnimal(ctx context.Context, texts []string) (proto.Word2Animal_BatchGetAnimalClient, error) {
+	if id := middleware.RequestIDFromContext(ctx); id != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "x-request-id", id)
 	}
-	return resp.Animal, resp.Confidence, nil
+	return c.client.BatchGetAnimal(ctx, &proto.BatchGetAnimalRequest{Texts: texts})
 }
 
 // Close closes the gRPC connection.
 func (c *Word2AnimalClient) Close() error {
 	return c.conn.Close()
 }
+
+// Conn returns the underlying gRPC connection, for callers (the gateway mux,
+// health checks) that need to share it instead of dialing their own.
+func (c *Word2AnimalClient) Conn() *grpc.ClientConn {
+	return c.conn
+}
@@ -0,0 +1,91 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// probeTimeout bounds a single Check RPC issued on a cache miss.
+const probeTimeout = 2 * time.Second
+
+// cacheTTL is how long a watched status is trusted before falling back to Check.
+const cacheTTL = 10 * time.Second
+
+// GRPCProbe watches the standard gRPC Health Checking Protocol for a service
+// over a shared connection and caches the last known status, so repeated
+// /ready polls don't each round-trip to the upstream.
+type GRPCProbe struct {
+	client  grpc_health_v1.HealthClient
+	service string
+
+	mu      sync.Mutex
+	status  grpc_health_v1.HealthCheckResponse_ServingStatus
+	checked time.Time
+}
+
+// NewGRPCProbe returns a probe for service on conn and starts a background
+// goroutine that watches it for as long as the process runs.
+func NewGRPCProbe(conn *grpc.ClientConn, service string) *GRPCProbe {
+	p := &GRPCProbe{
+		client:  grpc_health_v1.NewHealthClient(conn),
+		service: service,
+	}
+	go p.watch()
+	return p
+}
+
+// watch streams status updates for the lifetime of the process, reconnecting
+// the stream on error.
+func (p *GRPCProbe) watch() {
+	for {
+		stream, err := p.client.Watch(context.Background(), &grpc_health_v1.HealthCheckRequest{Service: p.service})
+		if err != nil {
+			time.Sleep(time.Second)
+			continue
+		}
+		for {
+			resp, err := stream.Recv()
+			if err != nil {
+				break
+			}
+			p.set(resp.Status)
+		}
+		time.Sleep(time.Second)
+	}
+}
+
+func (p *GRPCProbe) set(status grpc_health_v1.HealthCheckResponse_ServingStatus) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.status = status
+	p.checked = time.Now()
+}
+
+// Ready reports whether the watched service is SERVING. It returns the
+// cached status if it's fresher than cacheTTL, otherwise it falls back to a
+// direct Check call.
+func (p *GRPCProbe) Ready() error {
+	p.mu.Lock()
+	status, checked := p.status, p.checked
+	p.mu.Unlock()
+
+	if time.Since(checked) > cacheTTL {
+		ctx, cancel := context.WithTimeout(context.Background(), probeTimeout)
+		defer cancel()
+		resp, err := p.client.Check(ctx, &grpc_health_v1.HealthCheckRequest{Service: p.service})
+		if err != nil {
+			return fmt.Errorf("word2animal health check: %w", err)
+		}
+		p.set(resp.Status)
+		status = resp.Status
+	}
+	if status != grpc_health_v1.HealthCheckResponse_SERVING {
+		return fmt.Errorf("word2animal status: %s", status)
+	}
+	return nil
+}
@@ -0,0 +1,14 @@
+package middleware
+
+import "net/http"
+
+// MaxBodyBytes returns a middleware that caps request bodies at n bytes,
+// defense in depth alongside any handler-level length checks.
+func MaxBodyBytes(n int64) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			r.Body = http.MaxBytesReader(w, r.Body, n)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -1,8 +1,6 @@
 package middleware
 
 import (
-	"crypto/rand"
-	"encoding/hex"
 	"log/slog"
 	"net/http"
 	"time"
@@ -26,14 +24,26 @@ func (w *responseWriter) Write(b []byte) (int, error) {
 	return n, err
 }
 
+// Flush delegates to the wrapped ResponseWriter's Flush when it implements
+// http.Flusher. Embedding http.ResponseWriter only promotes that interface's
+// own method set, so without this, wrapping a writer here would silently
+// break any downstream handler (e.g. the chunk0-6 SSE batch endpoint) that
+// type-asserts w.(http.Flusher).
+func (w *responseWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
 // Logging returns a middleware that logs request_id, method, path, status, and duration_ms.
+// It reads the request ID from context, so it must run after RequestID.
 func Logging(logger *slog.Logger) func(http.Handler) http.Handler {
 	if logger == nil {
 		logger = slog.Default()
 	}
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			reqID := genRequestID()
+			reqID := RequestIDFromContext(r.Context())
 			start := time.Now()
 			wrap := &responseWriter{ResponseWriter: w, status: http.StatusOK}
 			next.ServeHTTP(wrap, r)
@@ -48,11 +58,3 @@ func Logging(logger *slog.Logger) func(http.Handler) http.Handler {
 		})
 	}
 }
-
-func genRequestID() string {
-	b := make([]byte, 8)
-	if _, err := rand.Read(b); err != nil {
-		return "unknown"
-	}
-	return hex.EncodeToString(b)
-}
@@ -0,0 +1,153 @@
+package middleware
+
+import (
+	"hash/fnv"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// idleLimiterTTL is how long a per-IP limiter can go unused before the sweep
+// goroutine evicts it, bounding memory under a churn of distinct IPs.
+const idleLimiterTTL = 10 * time.Minute
+
+// limiterShardCount spreads per-IP limiters across several independently
+// locked shards so requests for different IPs don't serialize on one mutex.
+const limiterShardCount = 32
+
+type limiterEntry struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+type limiterShard struct {
+	mu       sync.Mutex
+	limiters map[string]*limiterEntry
+}
+
+// rateLimiter enforces a per-IP token bucket plus a separate, materially
+// higher-capacity global bucket, so no single IP can starve the others
+// while aggregate traffic is still bounded independently of per-IP limits.
+type rateLimiter struct {
+	perIPRPS rate.Limit
+	burst    int
+	global   *rate.Limiter
+
+	trustedProxies []*net.IPNet
+	shards         [limiterShardCount]*limiterShard
+}
+
+// RateLimit returns a middleware that rejects requests with 429 once a
+// client IP exceeds perIPRPS requests/second with the given burst, or once
+// the server as a whole exceeds globalRPS/globalBurst. The global bucket is
+// deliberately a separate, larger allowance: sizing it the same as a single
+// per-IP bucket would let one IP's worth of traffic cap the entire server,
+// which is especially dangerous for callers sharing an IP (e.g. a k8s
+// liveness/readiness probe) with real clients. trustedProxies lists the
+// CIDRs allowed to set X-Forwarded-For; requests arriving from anywhere
+// else are keyed by RemoteAddr, since an untrusted client can set that
+// header to an arbitrary value to dodge its own bucket.
+func RateLimit(perIPRPS float64, burst int, globalRPS float64, globalBurst int, trustedProxies []*net.IPNet) func(http.Handler) http.Handler {
+	rl := &rateLimiter{
+		perIPRPS:       rate.Limit(perIPRPS),
+		burst:          burst,
+		global:         rate.NewLimiter(rate.Limit(globalRPS), globalBurst),
+		trustedProxies: trustedProxies,
+	}
+	for i := range rl.shards {
+		rl.shards[i] = &limiterShard{limiters: make(map[string]*limiterEntry)}
+	}
+	go rl.sweep()
+	return rl.middleware
+}
+
+func (rl *rateLimiter) middleware(next http.Handler) http.Handler {
+	retryAfter := strconv.Itoa(int(math.Ceil(1 / float64(rl.perIPRPS))))
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		limiter := rl.limiterFor(rl.clientIP(r))
+		if !rl.global.Allow() || !limiter.Allow() {
+			w.Header().Set("Retry-After", retryAfter)
+			http.Error(w, "rate limit exceeded", http.StatusTooManyRequests)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (rl *rateLimiter) shardFor(ip string) *limiterShard {
+	h := fnv.New32a()
+	h.Write([]byte(ip))
+	return rl.shards[h.Sum32()%limiterShardCount]
+}
+
+func (rl *rateLimiter) limiterFor(ip string) *rate.Limiter {
+	shard := rl.shardFor(ip)
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+	entry, ok := shard.limiters[ip]
+	if !ok {
+		entry = &limiterEntry{limiter: rate.NewLimiter(rl.perIPRPS, rl.burst)}
+		shard.limiters[ip] = entry
+	}
+	entry.lastSeen = time.Now()
+	return entry.limiter
+}
+
+// sweep evicts limiters that have been idle for longer than idleLimiterTTL.
+func (rl *rateLimiter) sweep() {
+	ticker := time.NewTicker(idleLimiterTTL)
+	defer ticker.Stop()
+	for range ticker.C {
+		cutoff := time.Now().Add(-idleLimiterTTL)
+		for _, shard := range rl.shards {
+			shard.mu.Lock()
+			for ip, entry := range shard.limiters {
+				if entry.lastSeen.Before(cutoff) {
+					delete(shard.limiters, ip)
+				}
+			}
+			shard.mu.Unlock()
+		}
+	}
+}
+
+// clientIP returns RemoteAddr with the port stripped, unless the request
+// came from a configured trusted proxy, in which case the first
+// X-Forwarded-For entry is used instead. Without a trusted proxy list,
+// X-Forwarded-For is never honored, since any client can set it to an
+// arbitrary or rotating value to get a fresh bucket on every request.
+func (rl *rateLimiter) clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	if !rl.isTrustedProxy(host) {
+		return host
+	}
+	if xff := r.Header.Get("X-Forwarded-For"); xff != "" {
+		if i := strings.IndexByte(xff, ','); i >= 0 {
+			return strings.TrimSpace(xff[:i])
+		}
+		return strings.TrimSpace(xff)
+	}
+	return host
+}
+
+func (rl *rateLimiter) isTrustedProxy(host string) bool {
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return false
+	}
+	for _, cidr := range rl.trustedProxies {
+		if cidr.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
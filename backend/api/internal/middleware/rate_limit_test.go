@@ -0,0 +1,38 @@
+package middleware
+
+import (
+	"net"
+	"net/http/httptest"
+	"testing"
+)
+
+func mustCIDR(t *testing.T, s string) *net.IPNet {
+	t.Helper()
+	_, n, err := net.ParseCIDR(s)
+	if err != nil {
+		t.Fatalf("ParseCIDR(%q): %v", s, err)
+	}
+	return n
+}
+
+func TestClientIPIgnoresUntrustedForwardedFor(t *testing.T) {
+	rl := &rateLimiter{} // no trusted proxies configured
+	req := httptest.NewRequest("POST", "/v1/text-to-animal", nil)
+	req.RemoteAddr = "203.0.113.5:1234"
+	req.Header.Set("X-Forwarded-For", "198.51.100.9")
+
+	if got := rl.clientIP(req); got != "203.0.113.5" {
+		t.Fatalf("clientIP = %q, want RemoteAddr host since no proxy is trusted", got)
+	}
+}
+
+func TestClientIPHonorsForwardedForFromTrustedProxy(t *testing.T) {
+	rl := &rateLimiter{trustedProxies: []*net.IPNet{mustCIDR(t, "10.0.0.0/8")}}
+	req := httptest.NewRequest("POST", "/v1/text-to-animal", nil)
+	req.RemoteAddr = "10.0.0.1:1234"
+	req.Header.Set("X-Forwarded-For", "198.51.100.9, 10.0.0.1")
+
+	if got := rl.clientIP(req); got != "198.51.100.9" {
+		t.Fatalf("clientIP = %q, want first X-Forwarded-For entry from a trusted proxy", got)
+	}
+}
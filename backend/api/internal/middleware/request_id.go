@@ -0,0 +1,46 @@
+package middleware
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"regexp"
+)
+
+type contextKey int
+
+const requestIDKey contextKey = iota
+
+// validRequestID accepts hex and UUID-shaped ids; anything else is treated
+// as untrusted and replaced with a generated one.
+var validRequestID = regexp.MustCompile(`^[0-9a-fA-F-]{8,64}$`)
+
+// RequestID reads X-Request-ID from the incoming request if present and
+// valid, or generates one, stores it on the request context, and echoes it
+// back on the response so callers can correlate logs across services.
+func RequestID(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get("X-Request-ID")
+		if !validRequestID.MatchString(id) {
+			id = genRequestID()
+		}
+		w.Header().Set("X-Request-ID", id)
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), requestIDKey, id)))
+	})
+}
+
+// RequestIDFromContext returns the request ID stored by RequestID, or "" if
+// none is present.
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey).(string)
+	return id
+}
+
+func genRequestID() string {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "unknown"
+	}
+	return hex.EncodeToString(b)
+}
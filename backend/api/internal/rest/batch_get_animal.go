@@ -0,0 +1,123 @@
+// Package rest holds hand-written HTTP handlers for endpoints that don't fit
+// the grpc-gateway model, such as this one's Server-Sent Events streaming.
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"brainball/api/internal/grpcclient"
+	"brainball/api/internal/middleware"
+)
+
+const (
+	maxBatchTexts = 100
+
+	// batchStreamTimeout bounds the whole streaming call; chunk0-4's
+	// interceptors only cover unary RPCs, so this is this call's own backstop.
+	batchStreamTimeout = 30 * time.Second
+)
+
+// batchGetAnimalRequest is the JSON body for POST /v1/text-to-animal:batch.
+type batchGetAnimalRequest struct {
+	Texts []string `json:"texts"`
+}
+
+// batchGetAnimalEvent is the payload of each "animal" SSE frame.
+type batchGetAnimalEvent struct {
+	Index      int     `json:"index"`
+	Animal     string  `json:"animal"`
+	Confidence float32 `json:"confidence,omitempty"`
+}
+
+// BatchGetAnimalHandler streams animal predictions for a batch of texts as
+// Server-Sent Events, one "animal" frame per completed prediction.
+type BatchGetAnimalHandler struct {
+	client *grpcclient.Word2AnimalClient
+}
+
+// NewBatchGetAnimalHandler returns a handler backed by client.
+func NewBatchGetAnimalHandler(client *grpcclient.Word2AnimalClient) *BatchGetAnimalHandler {
+	return &BatchGetAnimalHandler{client: client}
+}
+
+// ServeHTTP handles POST /v1/text-to-animal:batch.
+func (h *BatchGetAnimalHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	var req batchGetAnimalRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "invalid JSON", http.StatusBadRequest)
+		return
+	}
+	if len(req.Texts) == 0 || len(req.Texts) > maxBatchTexts {
+		http.Error(w, fmt.Sprintf("texts must have between 1 and %d entries", maxBatchTexts), http.StatusBadRequest)
+		return
+	}
+	for _, text := range req.Texts {
+		if text == "" || len(text) > grpcclient.MaxTextLength {
+			http.Error(w, fmt.Sprintf("each text must be non-empty and at most %d characters", grpcclient.MaxTextLength), http.StatusBadRequest)
+			return
+		}
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+
+	// An explicit deadline bounds the stream even if the client never
+	// disconnects and word2animal stalls; r.Context() still cancels it early
+	// on client disconnect since this is a child of that context.
+	ctx, cancel := context.WithTimeout(r.Context(), batchStreamTimeout)
+	defer cancel()
+	stream, err := h.client.BatchGetAnimal(ctx, req.Texts)
+	if err != nil {
+		http.Error(w, "word2animal unavailable", http.StatusServiceUnavailable)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	for {
+		resp, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			writeSSEEvent(w, "done", struct{}{})
+			flusher.Flush()
+			return
+		}
+		if err != nil {
+			log.Printf("batch get animal stream failed request_id=%s: %v", middleware.RequestIDFromContext(ctx), err)
+			writeSSEEvent(w, "error", map[string]string{"message": "stream failed"})
+			flusher.Flush()
+			return
+		}
+		writeSSEEvent(w, "animal", batchGetAnimalEvent{
+			Index:      int(resp.Index),
+			Animal:     resp.Animal,
+			Confidence: resp.Confidence,
+		})
+		flusher.Flush()
+	}
+}
+
+func writeSSEEvent(w http.ResponseWriter, event string, payload any) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return
+	}
+	fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, data)
+}
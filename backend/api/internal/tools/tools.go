@@ -0,0 +1,13 @@
+//go:build tools
+
+// Package tools pins the versions of the protoc plugins pkg/proto/word2animal.proto
+// is generated with (see ../../Makefile's proto target), so `go build`/`go install`
+// against this module's go.sum always produce the same plugin binaries.
+package tools
+
+import (
+	_ "google.golang.org/grpc/cmd/protoc-gen-go-grpc"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go"
+
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-grpc-gateway"
+)
@@ -0,0 +1,388 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        v4.25.0
+// source: word2animal/word2animal.proto
+
+package proto
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// GetAnimalRequest carries the text to classify.
+type GetAnimalRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *GetAnimalRequest) Reset() {
+	*x = GetAnimalRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_word2animal_word2animal_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAnimalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAnimalRequest) ProtoMessage() {}
+
+func (x *GetAnimalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_word2animal_word2animal_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAnimalRequest.ProtoReflect.Descriptor instead.
+func (*GetAnimalRequest) Descriptor() ([]byte, []int) {
+	return file_word2animal_word2animal_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetAnimalRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+// GetAnimalResponse carries the predicted animal and its confidence.
+type GetAnimalResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Animal     string  `protobuf:"bytes,1,opt,name=animal,proto3" json:"animal,omitempty"`
+	Confidence float32 `protobuf:"fixed32,2,opt,name=confidence,proto3" json:"confidence,omitempty"`
+}
+
+func (x *GetAnimalResponse) Reset() {
+	*x = GetAnimalResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_word2animal_word2animal_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAnimalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAnimalResponse) ProtoMessage() {}
+
+func (x *GetAnimalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_word2animal_word2animal_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAnimalResponse.ProtoReflect.Descriptor instead.
+func (*GetAnimalResponse) Descriptor() ([]byte, []int) {
+	return file_word2animal_word2animal_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetAnimalResponse) GetAnimal() string {
+	if x != nil {
+		return x.Animal
+	}
+	return ""
+}
+
+func (x *GetAnimalResponse) GetConfidence() float32 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+// BatchGetAnimalRequest carries multiple texts to classify in one streaming call.
+type BatchGetAnimalRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Texts []string `protobuf:"bytes,1,rep,name=texts,proto3" json:"texts,omitempty"`
+}
+
+func (x *BatchGetAnimalRequest) Reset() {
+	*x = BatchGetAnimalRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_word2animal_word2animal_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchGetAnimalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetAnimalRequest) ProtoMessage() {}
+
+func (x *BatchGetAnimalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_word2animal_word2animal_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetAnimalRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetAnimalRequest) Descriptor() ([]byte, []int) {
+	return file_word2animal_word2animal_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BatchGetAnimalRequest) GetTexts() []string {
+	if x != nil {
+		return x.Texts
+	}
+	return nil
+}
+
+// BatchGetAnimalResponse carries one text's prediction, identified by its
+// index in the request so out-of-order completions can still be matched up.
+type BatchGetAnimalResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index      int32   `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Animal     string  `protobuf:"bytes,2,opt,name=animal,proto3" json:"animal,omitempty"`
+	Confidence float32 `protobuf:"fixed32,3,opt,name=confidence,proto3" json:"confidence,omitempty"`
+}
+
+func (x *BatchGetAnimalResponse) Reset() {
+	*x = BatchGetAnimalResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_word2animal_word2animal_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchGetAnimalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetAnimalResponse) ProtoMessage() {}
+
+func (x *BatchGetAnimalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_word2animal_word2animal_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetAnimalResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetAnimalResponse) Descriptor() ([]byte, []int) {
+	return file_word2animal_word2animal_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BatchGetAnimalResponse) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *BatchGetAnimalResponse) GetAnimal() string {
+	if x != nil {
+		return x.Animal
+	}
+	return ""
+}
+
+func (x *BatchGetAnimalResponse) GetConfidence() float32 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+var File_word2animal_word2animal_proto protoreflect.FileDescriptor
+
+var file_word2animal_word2animal_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x77, 0x6f, 0x72, 0x64, 0x32, 0x61, 0x6e, 0x69, 0x6d, 0x61, 0x6c, 0x2f, 0x77, 0x6f,
+	0x72, 0x64, 0x32, 0x61, 0x6e, 0x69, 0x6d, 0x61, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x0b, 0x77, 0x6f, 0x72, 0x64, 0x32, 0x61, 0x6e, 0x69, 0x6d, 0x61, 0x6c, 0x1a, 0x1c, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x26, 0x0a, 0x10, 0x47, 0x65,
+	0x74, 0x41, 0x6e, 0x69, 0x6d, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65,
+	0x78, 0x74, 0x22, 0x4b, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x69, 0x6d, 0x61, 0x6c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6e, 0x69, 0x6d, 0x61,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6e, 0x69, 0x6d, 0x61, 0x6c, 0x12,
+	0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x22,
+	0x2d, 0x0a, 0x15, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x69, 0x6d, 0x61,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x65, 0x78, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x65, 0x78, 0x74, 0x73, 0x22, 0x66,
+	0x0a, 0x16, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x69, 0x6d, 0x61, 0x6c,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x6e, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x61, 0x6e, 0x69, 0x6d, 0x61, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64,
+	0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x32, 0xd5, 0x01, 0x0a, 0x0b, 0x57, 0x6f, 0x72, 0x64, 0x32,
+	0x41, 0x6e, 0x69, 0x6d, 0x61, 0x6c, 0x12, 0x69, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x69,
+	0x6d, 0x61, 0x6c, 0x12, 0x1d, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x32, 0x61, 0x6e, 0x69, 0x6d, 0x61,
+	0x6c, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x69, 0x6d, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x32, 0x61, 0x6e, 0x69, 0x6d, 0x61, 0x6c,
+	0x2e, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x69, 0x6d, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x3a, 0x01, 0x2a, 0x22, 0x12, 0x2f,
+	0x76, 0x31, 0x2f, 0x74, 0x65, 0x78, 0x74, 0x2d, 0x74, 0x6f, 0x2d, 0x61, 0x6e, 0x69, 0x6d, 0x61,
+	0x6c, 0x12, 0x5b, 0x0a, 0x0e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x69,
+	0x6d, 0x61, 0x6c, 0x12, 0x22, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x32, 0x61, 0x6e, 0x69, 0x6d, 0x61,
+	0x6c, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x69, 0x6d, 0x61, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x77, 0x6f, 0x72, 0x64, 0x32, 0x61,
+	0x6e, 0x69, 0x6d, 0x61, 0x6c, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x41, 0x6e,
+	0x69, 0x6d, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x42, 0x1f,
+	0x5a, 0x1d, 0x62, 0x72, 0x61, 0x69, 0x6e, 0x62, 0x61, 0x6c, 0x6c, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x3b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_word2animal_word2animal_proto_rawDescOnce sync.Once
+	file_word2animal_word2animal_proto_rawDescData = file_word2animal_word2animal_proto_rawDesc
+)
+
+func file_word2animal_word2animal_proto_rawDescGZIP() []byte {
+	file_word2animal_word2animal_proto_rawDescOnce.Do(func() {
+		file_word2animal_word2animal_proto_rawDescData = protoimpl.X.CompressGZIP(file_word2animal_word2animal_proto_rawDescData)
+	})
+	return file_word2animal_word2animal_proto_rawDescData
+}
+
+var file_word2animal_word2animal_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_word2animal_word2animal_proto_goTypes = []interface{}{
+	(*GetAnimalRequest)(nil),       // 0: word2animal.GetAnimalRequest
+	(*GetAnimalResponse)(nil),      // 1: word2animal.GetAnimalResponse
+	(*BatchGetAnimalRequest)(nil),  // 2: word2animal.BatchGetAnimalRequest
+	(*BatchGetAnimalResponse)(nil), // 3: word2animal.BatchGetAnimalResponse
+}
+var file_word2animal_word2animal_proto_depIdxs = []int32{
+	0, // 0: word2animal.Word2Animal.GetAnimal:input_type -> word2animal.GetAnimalRequest
+	2, // 1: word2animal.Word2Animal.BatchGetAnimal:input_type -> word2animal.BatchGetAnimalRequest
+	1, // 2: word2animal.Word2Animal.GetAnimal:output_type -> word2animal.GetAnimalResponse
+	3, // 3: word2animal.Word2Animal.BatchGetAnimal:output_type -> word2animal.BatchGetAnimalResponse
+	2, // [2:4] is the sub-list for method output_type
+	0, // [0:2] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_word2animal_word2animal_proto_init() }
+func file_word2animal_word2animal_proto_init() {
+	if File_word2animal_word2animal_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_word2animal_word2animal_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAnimalRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_word2animal_word2animal_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAnimalResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_word2animal_word2animal_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchGetAnimalRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_word2animal_word2animal_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchGetAnimalResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_word2animal_word2animal_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_word2animal_word2animal_proto_goTypes,
+		DependencyIndexes: file_word2animal_word2animal_proto_depIdxs,
+		MessageInfos:      file_word2animal_word2animal_proto_msgTypes,
+	}.Build()
+	File_word2animal_word2animal_proto = out.File
+	file_word2animal_word2animal_proto_rawDesc = nil
+	file_word2animal_word2animal_proto_goTypes = nil
+	file_word2animal_word2animal_proto_depIdxs = nil
+}